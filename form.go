@@ -0,0 +1,57 @@
+package requests
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/url"
+)
+
+// WithFormBody sets Content-Type to application/x-www-form-urlencoded and
+// encodes values as the request body.
+func (r *Request) WithFormBody(values url.Values) *Request {
+	r.headers["Content-Type"] = "application/x-www-form-urlencoded"
+	r.bodyBytes = []byte(values.Encode())
+	return r
+}
+
+// WithMultipartFile builds a multipart/form-data body made up of the file
+// read from file under fieldName/filename plus any extraFields, and sets
+// the multipart boundary Content-Type header. The body is buffered in
+// memory (like the rest of Request's bodies, via bodyBytes) rather than
+// streamed, so it isn't suited to very large files. Any error encountered
+// while building the body is stored and surfaced when the request is
+// built.
+func (r *Request) WithMultipartFile(fieldName, filename string, file io.Reader, extraFields url.Values) *Request {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	part, err := writer.CreateFormFile(fieldName, filename)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	if _, err := io.Copy(part, file); err != nil {
+		r.err = err
+		return r
+	}
+
+	for key, values := range extraFields {
+		for _, value := range values {
+			if err := writer.WriteField(key, value); err != nil {
+				r.err = err
+				return r
+			}
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		r.err = err
+		return r
+	}
+
+	r.headers["Content-Type"] = writer.FormDataContentType()
+	r.bodyBytes = buf.Bytes()
+	return r
+}
@@ -0,0 +1,101 @@
+package requests
+
+import (
+	"context"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestWithFormBodyEncodesValues(t *testing.T) {
+	var gotContentType, gotBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotContentType = req.Header.Get("Content-Type")
+		body := make([]byte, req.ContentLength)
+		req.Body.Read(body)
+		gotBody = string(body)
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	values := url.Values{"name": {"alice"}, "age": {"30"}}
+	req := New(base, server.Client(), "application/json").WithFormBody(values)
+
+	if _, err := req.Post(context.Background(), "/"); err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+
+	if gotContentType != "application/x-www-form-urlencoded" {
+		t.Fatalf("unexpected Content-Type: %q", gotContentType)
+	}
+
+	got, err := url.ParseQuery(gotBody)
+	if err != nil {
+		t.Fatalf("parse received body as form: %v", err)
+	}
+	if got.Get("name") != "alice" || got.Get("age") != "30" {
+		t.Fatalf("unexpected form values: %v", got)
+	}
+}
+
+func TestWithMultipartFileSendsFieldsAndFileIntact(t *testing.T) {
+	var gotFileContents, gotFieldValue string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		if err != nil {
+			t.Fatalf("parse media type: %v", err)
+		}
+
+		reader := multipart.NewReader(req.Body, params["boundary"])
+		form, err := reader.ReadForm(1 << 20)
+		if err != nil {
+			t.Fatalf("read multipart form: %v", err)
+		}
+
+		gotFieldValue = form.Value["note"][0]
+
+		fileHeader := form.File["upload"][0]
+		f, err := fileHeader.Open()
+		if err != nil {
+			t.Fatalf("open uploaded file: %v", err)
+		}
+		defer f.Close()
+
+		buf := make([]byte, fileHeader.Size)
+		if _, err := f.Read(buf); err != nil && err.Error() != "EOF" {
+			t.Fatalf("read uploaded file: %v", err)
+		}
+		gotFileContents = string(buf)
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	req := New(base, server.Client(), "application/json").WithMultipartFile(
+		"upload", "hello.txt", strings.NewReader("hello, world"), url.Values{"note": {"a note"}},
+	)
+
+	if _, err := req.Post(context.Background(), "/"); err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+
+	if gotFileContents != "hello, world" {
+		t.Fatalf("expected file contents %q, got %q", "hello, world", gotFileContents)
+	}
+	if gotFieldValue != "a note" {
+		t.Fatalf("expected field value %q, got %q", "a note", gotFieldValue)
+	}
+}
@@ -0,0 +1,93 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GQLErrorLocation is the line/column of a GraphQL error within the query.
+type GQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GQLError is a single entry in a GraphQL response's "errors" array.
+type GQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Locations  []GQLErrorLocation     `json:"locations,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLErrors collects the "errors" array of a GraphQL response.
+type GraphQLErrors []GQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, gqlErr := range e {
+		messages[i] = gqlErr.Message
+	}
+	return fmt.Sprintf("requests: graphql errors: %s", strings.Join(messages, "; "))
+}
+
+type graphQLRequestBody struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Extensions    map[string]interface{} `json:"extensions,omitempty"`
+}
+
+type graphQLResponseBody struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors,omitempty"`
+}
+
+// WithGraphQLExtensions attaches an "extensions" field (e.g. a persisted
+// query's extensions.persistedQuery.sha256Hash) to the next GraphQL call.
+func (r *Request) WithGraphQLExtensions(extensions map[string]interface{}) *Request {
+	r.graphQLExtensions = extensions
+	return r
+}
+
+// GraphQL POSTs the standard {query, variables, operationName} envelope to
+// endpointPath and unmarshals the "data" field into the target set via
+// WithResult. If the server reports any errors, GraphQL returns them as a
+// GraphQLErrors error (data is not decoded in that case).
+func (r *Request) GraphQL(ctx context.Context, endpointPath, query string, variables map[string]interface{}, operationName string) error {
+	body := graphQLRequestBody{
+		Query:         query,
+		Variables:     variables,
+		OperationName: operationName,
+		Extensions:    r.graphQLExtensions,
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	r.bodyBytes = bodyBytes
+	r.headers["Content-Type"] = "application/json"
+
+	target := r.result
+	var envelope graphQLResponseBody
+	r.result = &envelope
+
+	if _, err := r.Do(ctx, http.MethodPost, endpointPath); err != nil {
+		return err
+	}
+
+	if len(envelope.Errors) > 0 {
+		return envelope.Errors
+	}
+
+	if target != nil && len(envelope.Data) > 0 {
+		if err := json.Unmarshal(envelope.Data, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestGraphQLDecodesDataOnSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if body.Variables["id"] != "42" {
+			t.Fatalf("expected variables to reach the server, got %v", body.Variables)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"name":"gopher"}}`))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	var result struct {
+		Name string `json:"name"`
+	}
+
+	req := New(base, server.Client(), "application/json").WithResult(&result)
+
+	err = req.GraphQL(context.Background(), "/graphql", "query { name }", map[string]interface{}{"id": "42"}, "GetName")
+	if err != nil {
+		t.Fatalf("GraphQL: %v", err)
+	}
+	if result.Name != "gopher" {
+		t.Fatalf("expected name %q, got %q", "gopher", result.Name)
+	}
+}
+
+func TestGraphQLReturnsErrorsThroughErrorReturn(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"errors":[{"message":"field not found"}]}`))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	req := New(base, server.Client(), "application/json")
+
+	err = req.GraphQL(context.Background(), "/graphql", "query { missing }", nil, "")
+	if err == nil {
+		t.Fatalf("expected a GraphQLErrors error, got nil")
+	}
+
+	gqlErrs, ok := err.(GraphQLErrors)
+	if !ok {
+		t.Fatalf("expected GraphQLErrors, got %T", err)
+	}
+	if len(gqlErrs) != 1 || gqlErrs[0].Message != "field not found" {
+		t.Fatalf("unexpected errors: %v", gqlErrs)
+	}
+}
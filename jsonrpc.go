@@ -0,0 +1,127 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+)
+
+var jsonRPCID int64
+
+func nextJSONRPCID() int64 {
+	return atomic.AddInt64(&jsonRPCID, 1)
+}
+
+// JSONRPCError is the "error" member of a JSON-RPC 2.0 response.
+type JSONRPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *JSONRPCError) Error() string {
+	return fmt.Sprintf("requests: jsonrpc error %d: %s", e.Code, e.Message)
+}
+
+// JSONRPCResponse is a single JSON-RPC 2.0 response envelope.
+type JSONRPCResponse struct {
+	ID     interface{}     `json:"id"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *JSONRPCError   `json:"error,omitempty"`
+}
+
+// JSONRPCCall describes a single call within a JSONRPCBatch.
+type JSONRPCCall struct {
+	Method string
+	Params interface{}
+}
+
+type jsonRPCRequestBody struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      int64       `json:"id"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+// JSONRPC marshals {"jsonrpc":"2.0","id":<auto>,"method":method,"params":params},
+// POSTs it to path, and unmarshals the response into a JSONRPCResponse,
+// decoding Result into the target set via WithResult when Error is nil.
+func (r *Request) JSONRPC(ctx context.Context, path, method string, params interface{}) (*JSONRPCResponse, error) {
+	body := jsonRPCRequestBody{
+		JSONRPC: "2.0",
+		ID:      nextJSONRPCID(),
+		Method:  method,
+		Params:  params,
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	r.bodyBytes = bodyBytes
+	r.headers["Content-Type"] = "application/json"
+
+	target := r.result
+	var envelope JSONRPCResponse
+	r.result = &envelope
+
+	if _, err := r.Do(ctx, http.MethodPost, path); err != nil {
+		return nil, err
+	}
+
+	if envelope.Error == nil && target != nil && len(envelope.Result) > 0 {
+		if err := json.Unmarshal(envelope.Result, target); err != nil {
+			return nil, err
+		}
+	}
+
+	return &envelope, nil
+}
+
+// JSONRPCBatch sends calls as a single JSON-RPC batch request and returns
+// responses in the same order as calls, regardless of the order the
+// server replies in.
+func (r *Request) JSONRPCBatch(ctx context.Context, path string, calls []JSONRPCCall) ([]*JSONRPCResponse, error) {
+	ids := make([]int64, len(calls))
+	batch := make([]jsonRPCRequestBody, len(calls))
+	for i, call := range calls {
+		id := nextJSONRPCID()
+		ids[i] = id
+		batch[i] = jsonRPCRequestBody{
+			JSONRPC: "2.0",
+			ID:      id,
+			Method:  call.Method,
+			Params:  call.Params,
+		}
+	}
+
+	bodyBytes, err := json.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+	r.bodyBytes = bodyBytes
+	r.headers["Content-Type"] = "application/json"
+
+	var envelopes []JSONRPCResponse
+	r.result = &envelopes
+
+	if _, err := r.Do(ctx, http.MethodPost, path); err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]*JSONRPCResponse, len(envelopes))
+	for i := range envelopes {
+		if id, ok := envelopes[i].ID.(float64); ok {
+			byID[int64(id)] = &envelopes[i]
+		}
+	}
+
+	responses := make([]*JSONRPCResponse, len(ids))
+	for i, id := range ids {
+		responses[i] = byID[id]
+	}
+
+	return responses, nil
+}
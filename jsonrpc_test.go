@@ -0,0 +1,64 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestJSONRPCBatchOrdersResponsesByRequestID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var batch []jsonRPCRequestBody
+		if err := json.NewDecoder(req.Body).Decode(&batch); err != nil {
+			t.Fatalf("decode batch: %v", err)
+		}
+
+		// Reply out of order to prove JSONRPCBatch re-sorts by ID.
+		responses := make([]JSONRPCResponse, len(batch))
+		for i, call := range batch {
+			responses[len(batch)-1-i] = JSONRPCResponse{
+				ID:     float64(call.ID),
+				Result: json.RawMessage(`"` + call.Method + `"`),
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(responses); err != nil {
+			t.Fatalf("encode responses: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	req := New(base, server.Client(), "application/json")
+
+	responses, err := req.JSONRPCBatch(context.Background(), "/", []JSONRPCCall{
+		{Method: "first"},
+		{Method: "second"},
+		{Method: "third"},
+	})
+	if err != nil {
+		t.Fatalf("JSONRPCBatch: %v", err)
+	}
+
+	wantMethods := []string{"first", "second", "third"}
+	for i, want := range wantMethods {
+		if responses[i] == nil {
+			t.Fatalf("response %d is nil", i)
+		}
+		var got string
+		if err := json.Unmarshal(responses[i].Result, &got); err != nil {
+			t.Fatalf("unmarshal result %d: %v", i, err)
+		}
+		if got != want {
+			t.Fatalf("response %d: got %q, want %q", i, got, want)
+		}
+	}
+}
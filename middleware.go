@@ -0,0 +1,105 @@
+package requests
+
+import (
+	"net/http"
+	"time"
+)
+
+// RoundTripFunc performs a single HTTP round trip, mirroring
+// http.RoundTripper.RoundTrip as a plain function so middleware can be
+// composed without swapping out the shared http.Client's transport.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc to add cross-cutting behavior (logging,
+// metrics, auth, request IDs, etc.) around a request.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware appends mw to the chain executed around the request's
+// underlying client.Do call, in the order given (the first middleware is
+// outermost).
+func (r *Request) WithMiddleware(mw ...Middleware) *Request {
+	r.middleware = append(r.middleware, mw...)
+	return r
+}
+
+func (r *Request) roundTrip(req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(r.client.Do)
+
+	for i := len(r.middleware) - 1; i >= 0; i-- {
+		next = r.middleware[i](next)
+	}
+
+	return next(req)
+}
+
+// LoggingMiddleware logs the method and URL of each outgoing request and
+// the resulting status code or error.
+func LoggingMiddleware(logf func(format string, args ...interface{})) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil {
+				logf("requests: %s %s failed: %v", req.Method, req.URL, err)
+				return resp, err
+			}
+			logf("requests: %s %s -> %s", req.Method, req.URL, resp.Status)
+			return resp, err
+		}
+	}
+}
+
+// MetricsMiddleware reports the duration and resulting status code of
+// each request via record.
+func MetricsMiddleware(record func(method string, statusCode int, duration time.Duration)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			record(req.Method, statusCode, time.Since(start))
+
+			return resp, err
+		}
+	}
+}
+
+// UserAgentMiddleware sets the User-Agent header on every outgoing
+// request.
+func UserAgentMiddleware(userAgent string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("User-Agent", userAgent)
+			return next(req)
+		}
+	}
+}
+
+// BearerTokenMiddleware fetches a (possibly refreshed) token before each
+// request and sets it on the Authorization header.
+func BearerTokenMiddleware(token func() (string, error)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			t, err := token()
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Authorization", "Bearer "+t)
+			return next(req)
+		}
+	}
+}
+
+// RequestIDMiddleware sets header to an ID generated by nextID on every
+// outgoing request, for propagation/tracing purposes.
+func RequestIDMiddleware(header string, nextID func() string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set(header, nextID())
+			return next(req)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestWithMiddlewareMutatesOutgoingRequest(t *testing.T) {
+	var gotUserAgent, gotRequestID string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotUserAgent = req.Header.Get("User-Agent")
+		gotRequestID = req.Header.Get("X-Request-ID")
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	req := New(base, server.Client(), "application/json").WithMiddleware(
+		UserAgentMiddleware("requests-test/1.0"),
+		RequestIDMiddleware("X-Request-ID", func() string { return "req-123" }),
+	)
+
+	if _, err := req.Get(context.Background(), "/"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if gotUserAgent != "requests-test/1.0" {
+		t.Fatalf("expected User-Agent to be set by middleware, got %q", gotUserAgent)
+	}
+	if gotRequestID != "req-123" {
+		t.Fatalf("expected X-Request-ID to be set by middleware, got %q", gotRequestID)
+	}
+}
+
+func TestWithMiddlewareOutermostRunsFirst(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	var order []string
+	mw := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next(req)
+			}
+		}
+	}
+
+	req := New(base, server.Client(), "application/json").WithMiddleware(mw("outer"), mw("inner"))
+
+	if _, err := req.Get(context.Background(), "/"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if len(order) != 2 || order[0] != "outer" || order[1] != "inner" {
+		t.Fatalf("expected [outer inner] execution order, got %v", order)
+	}
+}
@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"github.com/google/go-querystring/query"
+)
+
+// WithQueryStruct reflects over v using `url:"..."` struct tags (see
+// github.com/google/go-querystring/query) and merges the resulting values
+// into the request's query parameters. Any encoding error is stored and
+// surfaced when the request is built, so the chained builder API is
+// preserved.
+func (r *Request) WithQueryStruct(v interface{}) *Request {
+	values, err := query.Values(v)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	for key, vals := range values {
+		for _, val := range vals {
+			r.queryParams.Add(key, val)
+		}
+	}
+
+	return r
+}
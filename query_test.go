@@ -0,0 +1,46 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type searchParams struct {
+	Query string   `url:"q"`
+	Tags  []string `url:"tags,omitempty"`
+}
+
+func TestWithQueryStructMergesWithWithQueryParam(t *testing.T) {
+	var gotQuery url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotQuery = req.URL.Query()
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	req := New(base, server.Client(), "application/json").
+		WithQueryParam("page", "2").
+		WithQueryStruct(searchParams{Query: "golang", Tags: []string{"http", "client"}})
+
+	if _, err := req.Get(context.Background(), "/"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if gotQuery.Get("page") != "2" {
+		t.Fatalf("expected page=2, got %q", gotQuery.Get("page"))
+	}
+	if gotQuery.Get("q") != "golang" {
+		t.Fatalf("expected q=golang, got %q", gotQuery.Get("q"))
+	}
+	if tags := gotQuery["tags"]; len(tags) != 2 || tags[0] != "http" || tags[1] != "client" {
+		t.Fatalf("unexpected tags: %v", tags)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -17,6 +18,17 @@ type Request struct {
 	body        interface{}
 	bodyBytes   []byte
 	result      interface{}
+	err         error
+
+	errorResult  interface{}
+	expectStatus []int
+
+	retryPolicy *RetryPolicy
+	getBody     func() (io.ReadCloser, error)
+
+	middleware []Middleware
+
+	graphQLExtensions map[string]interface{}
 }
 
 func New(requestURL *url.URL, httpClient *http.Client, accepts string) *Request {
@@ -117,18 +129,17 @@ func (r *Request) WithResult(result interface{}) *Request {
 }
 
 func (r *Request) Request(ctx context.Context, method, path string) (*http.Request, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
 	ref, err := url.Parse(path)
 	if err != nil {
 		return nil, err
 	}
 
 	refURL := r.url.ResolveReference(ref)
-
-	q, err := url.QueryUnescape(r.queryParams.Encode())
-	if err != nil {
-		return nil, err
-	}
-	refURL.RawQuery = q
+	refURL.RawQuery = r.queryParams.Encode()
 
 	req, err := http.NewRequestWithContext(ctx, method, refURL.String(), bytes.NewReader(r.bodyBytes))
 	if err != nil {
@@ -146,24 +157,17 @@ func (r *Request) Request(ctx context.Context, method, path string) (*http.Reque
 	return req, err
 }
 
+// do is the status-aware implementation behind Get/Post/Put/Patch/Delete:
+// it routes through Do so a non-expected status surfaces as *HTTPError
+// instead of being silently decoded into r.result.
 func (r *Request) do(ctx context.Context, method, path string) (interface{}, error) {
-	req, err := r.Request(ctx, method, path)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := r.client.Do(req)
+	response, err := r.Do(ctx, method, path)
 	if err != nil {
 		return nil, err
 	}
 
-	defer resp.Body.Close()
-
-	if r.result != nil {
-		err = json.NewDecoder(resp.Body).Decode(r.result)
-		if err != nil {
-			return nil, err
-		}
+	if r.result == nil && response != nil && response.Raw != nil {
+		response.Raw.Body.Close()
 	}
 
 	return r.result, nil
@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestGetOnNon2xxReturnsHTTPErrorInsteadOfSilentlyDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"name":"should not be decoded as success"}`))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	var result struct {
+		Name string `json:"name"`
+	}
+
+	req := New(base, server.Client(), "application/json").WithResult(&result)
+
+	if _, err := req.Get(context.Background(), "/"); err == nil {
+		t.Fatalf("expected an error for a 404 response, got nil")
+	} else if httpErr, ok := err.(*HTTPError); !ok {
+		t.Fatalf("expected *HTTPError, got %T: %v", err, err)
+	} else if httpErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, httpErr.StatusCode)
+	}
+
+	if result.Name != "" {
+		t.Fatalf("expected result to be left undecoded on error, got %q", result.Name)
+	}
+}
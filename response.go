@@ -0,0 +1,120 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Response wraps the outcome of a single HTTP round trip, exposing the
+// status code, headers, and the underlying *http.Response in addition to
+// whatever WithResult decoded the body into.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Raw        *http.Response
+}
+
+// HTTPError is returned by Do when a response's status code does not
+// satisfy the request's expectations (any 2xx status by default, or the
+// codes passed to ExpectStatus). Body holds the raw response body, and
+// Decoded holds it unmarshaled into the target passed to WithErrorResult,
+// if any. If the body could not be unmarshaled (a non-JSON error page,
+// for instance), Decoded is left nil and DecodeErr holds the cause; the
+// error itself is still StatusCode/Status/Body-complete.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+	Decoded    interface{}
+	DecodeErr  error
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("requests: unexpected status %q", e.Status)
+}
+
+// WithErrorResult sets the target that responses with an unexpected status
+// (non-2xx by default, see ExpectStatus) are decoded into; the decoded
+// value is exposed via HTTPError.Decoded.
+func (r *Request) WithErrorResult(target interface{}) *Request {
+	r.errorResult = target
+	return r
+}
+
+// ExpectStatus restricts the set of status codes Do treats as successful.
+// When unset, any 2xx status is considered successful.
+func (r *Request) ExpectStatus(codes ...int) *Request {
+	r.expectStatus = codes
+	return r
+}
+
+func (r *Request) isExpectedStatus(statusCode int) bool {
+	if len(r.expectStatus) == 0 {
+		return statusCode >= 200 && statusCode < 300
+	}
+
+	for _, code := range r.expectStatus {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Do performs the request for method/path and returns the full Response.
+// On an expected status it decodes the body into WithResult's target, if
+// set; otherwise it returns a *HTTPError, decoding the body into
+// WithErrorResult's target, if set. When neither target is set on an
+// expected status, the body is left open on Response.Raw for the caller
+// to read and close themselves (e.g. to stream a download).
+func (r *Request) Do(ctx context.Context, method, path string) (*Response, error) {
+	resp, err := r.sendRequest(ctx, method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Raw:        resp,
+	}
+
+	if !r.isExpectedStatus(resp.StatusCode) {
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return response, err
+		}
+
+		httpErr := &HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       body,
+		}
+
+		if r.errorResult != nil {
+			if err := json.Unmarshal(body, r.errorResult); err != nil {
+				httpErr.DecodeErr = err
+			} else {
+				httpErr.Decoded = r.errorResult
+			}
+		}
+
+		return response, httpErr
+	}
+
+	if r.result != nil {
+		defer resp.Body.Close()
+
+		if err := json.NewDecoder(resp.Body).Decode(r.result); err != nil {
+			return response, err
+		}
+	}
+
+	return response, nil
+}
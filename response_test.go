@@ -0,0 +1,83 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestDoWithErrorResultNonJSONBodyKeepsHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html>bad gateway</html>"))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	var errTarget struct {
+		Message string `json:"message"`
+	}
+
+	req := New(base, server.Client(), "application/json").WithErrorResult(&errTarget)
+
+	_, err = req.Do(context.Background(), http.MethodGet, "/")
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	httpErr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected *HTTPError, got %T", err)
+	}
+
+	if httpErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected status %d, got %d", http.StatusBadGateway, httpErr.StatusCode)
+	}
+	if string(httpErr.Body) != "<html>bad gateway</html>" {
+		t.Fatalf("unexpected body: %s", httpErr.Body)
+	}
+	if httpErr.Decoded != nil {
+		t.Fatalf("expected Decoded to be nil for a non-JSON body")
+	}
+	if httpErr.DecodeErr == nil {
+		t.Fatalf("expected DecodeErr to be set for a non-JSON body")
+	}
+}
+
+func TestDoWithErrorResultDecodesJSONBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	var errTarget struct {
+		Message string `json:"message"`
+	}
+
+	req := New(base, server.Client(), "application/json").WithErrorResult(&errTarget)
+
+	_, err = req.Do(context.Background(), http.MethodGet, "/")
+	httpErr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected *HTTPError, got %T", err)
+	}
+
+	if httpErr.DecodeErr != nil {
+		t.Fatalf("unexpected DecodeErr: %v", httpErr.DecodeErr)
+	}
+	if errTarget.Message != "not found" {
+		t.Fatalf("expected decoded message %q, got %q", "not found", errTarget.Message)
+	}
+}
@@ -0,0 +1,195 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retry behavior for a Request.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times the request is sent, including
+	// the first attempt. Values <= 0 are treated as 1 (no retries).
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Jitter      bool
+
+	// ShouldRetry decides whether a given attempt should be retried. When
+	// nil, defaultShouldRetry is used, which retries on transport errors
+	// and 429/503 responses.
+	ShouldRetry func(resp *http.Response, err error) bool
+}
+
+// RateLimitedError is returned when a request exhausts its retries while
+// being rate limited (429) or throttled (503).
+type RateLimitedError struct {
+	RetryAfter time.Duration
+	Status     string
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("requests: rate limited (retry after %s)", e.RetryAfter)
+}
+
+// Retryable reports whether callers can usefully retry after RetryAfter.
+func (e *RateLimitedError) Retryable() bool {
+	return e.RetryAfter > 0
+}
+
+// WithRetry enables retries governed by policy for this request.
+func (r *Request) WithRetry(policy RetryPolicy) *Request {
+	r.retryPolicy = &policy
+	return r
+}
+
+// WithGetBody sets a function used to re-obtain the request body on each
+// retry attempt, following http.Request.GetBody semantics. Buffered bodies
+// (the common case, via bodyBytes) are already replayed automatically.
+// When set, getBody is called before every retry and what it returns is
+// read in full into bodyBytes, so the retried body is buffered in memory;
+// the first attempt sends the body already set on the request.
+func (r *Request) WithGetBody(getBody func() (io.ReadCloser, error)) *Request {
+	r.getBody = getBody
+	return r
+}
+
+func defaultShouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when), true
+	}
+
+	return 0, false
+}
+
+func backoffDelay(policy *RetryPolicy, attempt int) time.Duration {
+	delay := policy.BaseDelay * time.Duration(1<<uint(attempt))
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if policy.Jitter && delay > 0 {
+		delay = time.Duration(rand.Int63n(int64(delay)))
+	}
+	return delay
+}
+
+// resetBody re-buffers r.bodyBytes ahead of a retry attempt using getBody,
+// if one was configured.
+func (r *Request) resetBody() error {
+	if r.getBody == nil {
+		return nil
+	}
+
+	body, err := r.getBody()
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	r.bodyBytes = b
+	return nil
+}
+
+// sendRequest builds and sends the request for method/path, retrying
+// according to r.retryPolicy when one is set.
+func (r *Request) sendRequest(ctx context.Context, method, path string) (*http.Response, error) {
+	if r.retryPolicy == nil {
+		req, err := r.Request(ctx, method, path)
+		if err != nil {
+			return nil, err
+		}
+		return r.roundTrip(req)
+	}
+
+	policy := *r.retryPolicy
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 1
+	}
+
+	shouldRetry := policy.ShouldRetry
+	if shouldRetry == nil {
+		shouldRetry = defaultShouldRetry
+	}
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			if resetErr := r.resetBody(); resetErr != nil {
+				return nil, resetErr
+			}
+		}
+
+		var req *http.Request
+		req, err = r.Request(ctx, method, path)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = r.roundTrip(req)
+		if !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		var retryAfter time.Duration
+		rateLimited := false
+		if resp != nil {
+			rateLimited = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+			if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				retryAfter = d
+			}
+		}
+
+		// This was the last attempt: a rate-limited response is closed and
+		// reported as a RateLimitedError; any other exhausted response/error
+		// is returned to the caller with its body intact.
+		if attempt == policy.MaxAttempts-1 {
+			if rateLimited {
+				resp.Body.Close()
+				return nil, &RateLimitedError{RetryAfter: retryAfter, Status: resp.Status}
+			}
+			break
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if retryAfter == 0 {
+			retryAfter = backoffDelay(&policy, attempt)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryAfter):
+		}
+	}
+
+	return resp, err
+}
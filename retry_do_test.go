@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRetryExhaustedKeepsResponseBodyReadable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message":"server exploded"}`))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	req := New(base, server.Client(), "application/json").WithRetry(RetryPolicy{
+		MaxAttempts: 2,
+		BaseDelay:   time.Millisecond,
+		ShouldRetry: func(resp *http.Response, err error) bool {
+			return resp != nil && resp.StatusCode == http.StatusInternalServerError
+		},
+	})
+
+	response, err := req.Do(context.Background(), http.MethodGet, "/")
+	if err == nil {
+		t.Fatalf("expected an error for an exhausted-retry 500 response")
+	}
+
+	httpErr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected *HTTPError, got %T: %v", err, err)
+	}
+
+	if string(httpErr.Body) != `{"message":"server exploded"}` {
+		t.Fatalf("expected the real error body, got %q", httpErr.Body)
+	}
+	if response.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, response.StatusCode)
+	}
+}
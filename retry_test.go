@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfterDeltaSeconds(t *testing.T) {
+	d, ok := parseRetryAfter("120")
+	if !ok {
+		t.Fatalf("expected ok, got false")
+	}
+	if d != 120*time.Second {
+		t.Fatalf("expected 120s, got %s", d)
+	}
+}
+
+func TestParseRetryAfterHTTPDate(t *testing.T) {
+	when := time.Now().Add(30 * time.Second).UTC()
+	d, ok := parseRetryAfter(when.Format(http.TimeFormat))
+	if !ok {
+		t.Fatalf("expected ok, got false")
+	}
+	if d <= 0 || d > 31*time.Second {
+		t.Fatalf("expected duration close to 30s, got %s", d)
+	}
+}
+
+func TestParseRetryAfterEmpty(t *testing.T) {
+	if _, ok := parseRetryAfter(""); ok {
+		t.Fatalf("expected ok=false for empty header")
+	}
+}
+
+func TestParseRetryAfterInvalid(t *testing.T) {
+	if _, ok := parseRetryAfter("not-a-valid-value"); ok {
+		t.Fatalf("expected ok=false for invalid header")
+	}
+}